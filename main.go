@@ -1,31 +1,84 @@
-package main
-
-import (
-	"fmt"
-	"strconv"
-)
-
-func thisFunction() error {
-	err := someFunction()
-	return fmt.Errorf("this function: %w", err)
-}
-
-func someFunction() error {
-	_, err := stringToInt()
-	return fmt.Errorf("some function: %w", err)
-}
-
-func stringToInt() (int, error) {
-	number, err := strconv.Atoi("five")
-	if err != nil {
-		return 0, fmt.Errorf("string to int: %w", err)
-	}
-	return number, nil
-}
-
-func main() {
-	err := thisFunction()
-	if err != nil {
-		fmt.Println(err)
-	}
-}
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/kietpa/kietpa.github.io/pkg/tracederrors"
+)
+
+// ErrNotANumber is the sentinel joined into every Convert failure, so
+// callers can check for it regardless of the concrete type being parsed.
+var ErrNotANumber = errors.New("not a number")
+
+// ParseError describes a single failed conversion: the raw input, the
+// target type it was being converted to, and the underlying parse error.
+type ParseError struct {
+	Input string
+	Kind  string
+	Err   error
+}
+
+func (p *ParseError) Error() string {
+	return fmt.Sprintf("parse %q as %s: %v", p.Input, p.Kind, p.Err)
+}
+
+func (p *ParseError) Unwrap() error {
+	return p.Err
+}
+
+// Convert runs parse on s and, on failure, wraps the error in a *ParseError
+// joined with ErrNotANumber so callers can discriminate via either
+// errors.Is(err, ErrNotANumber) or errors.As(err, &parseErr).
+func Convert[T any](s string, parse func(string) (T, error)) (T, error) {
+	value, err := parse(s)
+	if err != nil {
+		parseErr := &ParseError{
+			Input: s,
+			Kind:  fmt.Sprintf("%T", *new(T)),
+			Err:   err,
+		}
+		return value, errors.Join(ErrNotANumber, parseErr)
+	}
+	return value, nil
+}
+
+func thisFunction() error {
+	err := someFunction()
+	return tracederrors.Wrap(err, "this function")
+}
+
+func someFunction() error {
+	_, err := stringToInt()
+	return tracederrors.Wrap(err, "some function")
+}
+
+func stringToInt() (int, error) {
+	number, err := Convert("five", strconv.Atoi)
+	if err != nil {
+		return 0, tracederrors.Errorf("string to int: %w", err)
+	}
+	return number, nil
+}
+
+func main() {
+	err := thisFunction()
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	var trace *tracederrors.Trace
+	if errors.As(err, &trace) {
+		fmt.Printf("outermost trace at %s:%d (%s)\n", trace.File, trace.Line, trace.Func)
+	}
+
+	if errors.Is(err, ErrNotANumber) {
+		fmt.Println("discriminated via errors.Is: input was not a number")
+	}
+
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Printf("discriminated via errors.As: failed to parse %q as %s\n", parseErr.Input, parseErr.Kind)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestConvertSuccess(t *testing.T) {
+	n, err := Convert("42", strconv.Atoi)
+	if err != nil {
+		t.Fatalf("Convert(42) returned error: %v", err)
+	}
+	if n != 42 {
+		t.Fatalf("Convert(42) = %d, want 42", n)
+	}
+}
+
+func TestConvertFailureDiscrimination(t *testing.T) {
+	_, err := Convert("five", strconv.Atoi)
+	if err == nil {
+		t.Fatal("Convert(five) returned nil error, want non-nil")
+	}
+
+	if !errors.Is(err, ErrNotANumber) {
+		t.Fatalf("errors.Is(err, ErrNotANumber) = false, want true: %v", err)
+	}
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("errors.As(err, &ParseError) = false, want true: %v", err)
+	}
+	if parseErr.Input != "five" {
+		t.Fatalf("ParseError.Input = %q, want %q", parseErr.Input, "five")
+	}
+	if parseErr.Kind != "int" {
+		t.Fatalf("ParseError.Kind = %q, want %q", parseErr.Kind, "int")
+	}
+}
@@ -0,0 +1,118 @@
+// Package tracederrors provides lightweight error wrapping that records the
+// file, line, and function of the call site, similar to the stack-trace
+// helpers found in libraries like pkg/errors.
+package tracederrors
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// Trace wraps an error with the location of the call that wrapped it.
+type Trace struct {
+	File string
+	Line int
+	Func string
+	Msg  string
+	err  error
+}
+
+// Error renders as "file:line function: message: <wrapped>". When Msg is
+// empty (as for Errorf, where the wrapped error's own text already carries
+// the message), the middle segment is omitted.
+func (t *Trace) Error() string {
+	switch {
+	case t.err == nil:
+		return fmt.Sprintf("%s:%d %s: %s", t.File, t.Line, t.Func, t.Msg)
+	case t.Msg == "":
+		return fmt.Sprintf("%s:%d %s: %s", t.File, t.Line, t.Func, t.err.Error())
+	default:
+		return fmt.Sprintf("%s:%d %s: %s: %s", t.File, t.Line, t.Func, t.Msg, t.err.Error())
+	}
+}
+
+// Unwrap returns the wrapped error so errors.Is/errors.As can traverse the chain.
+func (t *Trace) Unwrap() error {
+	return t.err
+}
+
+// Is reports whether target is a *Trace with the same location and message,
+// so that errors.Is can also match a Trace against another Trace directly.
+func (t *Trace) Is(target error) bool {
+	other, ok := target.(*Trace)
+	if !ok {
+		return false
+	}
+	return t.File == other.File && t.Line == other.Line && t.Func == other.Func && t.Msg == other.Msg
+}
+
+// As supports errors.As by handing back itself when target is a **Trace.
+func (t *Trace) As(target interface{}) bool {
+	tp, ok := target.(**Trace)
+	if !ok {
+		return false
+	}
+	*tp = t
+	return true
+}
+
+func caller(skip int) (file string, line int, function string) {
+	pc, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return "unknown", 0, "unknown"
+	}
+	file = filepath.Base(file)
+	function = "unknown"
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		function = fn.Name()
+	}
+	return file, line, function
+}
+
+// Wrap wraps err with the caller's file, line, and function. msgAndArgs
+// builds the message: if the first element is a string followed by more
+// args it is used as a format string with fmt.Sprintf, otherwise the
+// arguments are concatenated with fmt.Sprint. Wrap returns nil
+// if err is nil.
+func Wrap(err error, msgAndArgs ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	file, line, function := caller(2)
+	return &Trace{
+		File: file,
+		Line: line,
+		Func: function,
+		Msg:  formatMsg(msgAndArgs),
+		err:  err,
+	}
+}
+
+// Errorf formats a new error and records the caller's file, line, and
+// function, the same way fmt.Errorf does with %w but with location info.
+// Like fmt.Errorf, format may contain zero, one, or multiple %w verbs. The
+// Trace unwraps to the error fmt.Errorf built; in the multi-%w case that
+// error has an Unwrap() []error method, so errors.Is/errors.As still
+// traverse to every wrapped error.
+func Errorf(format string, args ...interface{}) error {
+	file, line, function := caller(2)
+	inner := fmt.Errorf(format, args...)
+
+	return &Trace{
+		File: file,
+		Line: line,
+		Func: function,
+		err:  inner,
+	}
+}
+
+func formatMsg(msgAndArgs []interface{}) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok && len(msgAndArgs) > 1 {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return fmt.Sprint(msgAndArgs...)
+}
@@ -0,0 +1,97 @@
+package tracederrors
+
+import (
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWrapChainTraversal(t *testing.T) {
+	sentinel := errors.New("boom")
+	wrapped := Wrap(sentinel, "middle")
+	wrapped = Wrap(wrapped, "outer")
+
+	if !errors.Is(wrapped, sentinel) {
+		t.Fatalf("errors.Is: expected chain to reach sentinel, got %v", wrapped)
+	}
+
+	var trace *Trace
+	if !errors.As(wrapped, &trace) {
+		t.Fatalf("errors.As: expected chain to contain a *Trace, got %v", wrapped)
+	}
+}
+
+func TestWrapNil(t *testing.T) {
+	if err := Wrap(nil, "no-op"); err != nil {
+		t.Fatalf("Wrap(nil) = %v, want nil", err)
+	}
+}
+
+func TestWrapCapturesCallSiteLine(t *testing.T) {
+	sentinel := errors.New("boom")
+	_, _, thisLine, _ := runtime.Caller(0)
+	err := Wrap(sentinel, "here") // must be the very next line
+
+	var trace *Trace
+	if !errors.As(err, &trace) {
+		t.Fatalf("errors.As: expected a *Trace, got %v", err)
+	}
+	if trace.Line != thisLine+1 {
+		t.Fatalf("Trace.Line = %d, want %d (the Wrap call site, not inside tracederrors)", trace.Line, thisLine+1)
+	}
+	if trace.File != "tracederrors_test.go" {
+		t.Fatalf("Trace.File = %q, want tracederrors_test.go", trace.File)
+	}
+}
+
+func TestErrorfFormatsMessageAndWraps(t *testing.T) {
+	sentinel := errors.New("not found")
+	err := Errorf("lookup %q: %w", "key", sentinel)
+
+	if !errors.Is(err, sentinel) {
+		t.Fatalf("errors.Is: expected chain to reach sentinel, got %v", err)
+	}
+
+	got := err.Error()
+	if !strings.Contains(got, `lookup "key"`) {
+		t.Fatalf("Error() = %q, want it to contain the formatted message", got)
+	}
+	if !strings.HasSuffix(got, ": not found") {
+		t.Fatalf("Error() = %q, want it to end with the wrapped error's message", got)
+	}
+}
+
+func TestErrorfMultiWrapReachesBothErrors(t *testing.T) {
+	e1 := errors.New("first")
+	e2 := errors.New("second")
+	err := Errorf("combo: %w and %w", e1, e2)
+
+	if !errors.Is(err, e1) {
+		t.Fatalf("errors.Is(err, e1) = false, want true: %v", err)
+	}
+	if !errors.Is(err, e2) {
+		t.Fatalf("errors.Is(err, e2) = false, want true: %v", err)
+	}
+
+	got := err.Error()
+	if !strings.Contains(got, "first") || !strings.Contains(got, "second") {
+		t.Fatalf("Error() = %q, want it to mention both wrapped errors", got)
+	}
+}
+
+func TestTraceErrorFormat(t *testing.T) {
+	sentinel := errors.New("inner")
+	err := Wrap(sentinel, "outer")
+
+	var trace *Trace
+	if !errors.As(err, &trace) {
+		t.Fatalf("errors.As: expected a *Trace, got %v", err)
+	}
+
+	want := trace.File + ":" + strconv.Itoa(trace.Line) + " " + trace.Func + ": outer: inner"
+	if trace.Error() != want {
+		t.Fatalf("Error() = %q, want %q", trace.Error(), want)
+	}
+}